@@ -0,0 +1,668 @@
+package sets
+
+import (
+	"cmp"
+	"iter"
+	"sync"
+)
+
+// ConcurrentOrderedMap is a concurrency-safe variant of OrderedMap. Unlike
+// ConcurrentSeenSet, it is not sharded: OrderedMap answers ordering queries
+// (Min, Floor, Range, ...) from a single sorted key slice, so splitting it
+// across shards would make "the n-th smallest key" a moving target instead
+// of a well-defined answer. A single sync.RWMutex guards the whole map.
+//
+// The zero value is a valid, empty ConcurrentOrderedMap: it lazily
+// allocates its underlying OrderedMap on first use, so it does not have to
+// be constructed via NewConcurrentOrderedMap.
+type ConcurrentOrderedMap[K cmp.Ordered, V any] struct {
+	once sync.Once
+	mu   sync.RWMutex
+	m    *OrderedMap[K, V]
+}
+
+// NewConcurrentOrderedMap creates a new empty ConcurrentOrderedMap.
+//
+// Returns:
+//   - *ConcurrentOrderedMap[K, V]: The created map. Never returns nil.
+func NewConcurrentOrderedMap[K cmp.Ordered, V any]() *ConcurrentOrderedMap[K, V] {
+	m := &ConcurrentOrderedMap[K, V]{}
+	m.init()
+
+	return m
+}
+
+// init lazily allocates the underlying OrderedMap, so that a zero-value
+// ConcurrentOrderedMap is safe to use without going through
+// NewConcurrentOrderedMap. Safe to call concurrently and safe to call on
+// every method entry: it does nothing past the first call.
+func (m *ConcurrentOrderedMap[K, V]) init() {
+	m.once.Do(func() {
+		m.m = NewOrderedMap[K, V]()
+	})
+}
+
+// IsEmpty implements the Set interface.
+func (m *ConcurrentOrderedMap[K, V]) IsEmpty() bool {
+	if m == nil {
+		return true
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.IsEmpty()
+}
+
+// Size implements the Set interface.
+func (m *ConcurrentOrderedMap[K, V]) Size() int {
+	if m == nil {
+		return 0
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Size()
+}
+
+// Reset implements the Set interface.
+func (m *ConcurrentOrderedMap[K, V]) Reset() {
+	if m == nil {
+		return
+	}
+
+	m.init()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.Reset()
+}
+
+// Get returns the value of the key in the map.
+//
+// Parameters:
+//   - key: The key to get.
+//
+// Returns:
+//   - V: The value of the key in the map.
+//   - bool: True if the key exists in the map. False if the key does not exist.
+func (m *ConcurrentOrderedMap[K, V]) Get(key K) (V, bool) {
+	if m == nil {
+		return *new(V), false
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Get(key)
+}
+
+// Contains checks if the key exists in the map.
+//
+// Parameters:
+//   - key: The key to check.
+//
+// Returns:
+//   - bool: True if the key exists in the map. False if the key does not exist.
+func (m *ConcurrentOrderedMap[K, V]) Contains(key K) bool {
+	if m == nil {
+		return false
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Contains(key)
+}
+
+// Add adds a key-value pair to the map. Does nothing if the receiver is nil
+// or the key already exists.
+//
+// Parameters:
+//   - key: The key to add.
+//   - value: The value to add.
+func (m *ConcurrentOrderedMap[K, V]) Add(key K, value V) {
+	if m == nil {
+		return
+	}
+
+	m.init()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.Add(key, value)
+}
+
+// ForceAdd is the same as Add, except that it will overwrite the value if
+// the key already exists.
+//
+// Parameters:
+//   - key: The key to add.
+//   - value: The value to add.
+func (m *ConcurrentOrderedMap[K, V]) ForceAdd(key K, value V) {
+	if m == nil {
+		return
+	}
+
+	m.init()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.ForceAdd(key, value)
+}
+
+// Remove removes the key from the map. Does nothing if the receiver is nil
+// or the key does not exist.
+//
+// Parameters:
+//   - key: The key to remove.
+func (m *ConcurrentOrderedMap[K, V]) Remove(key K) {
+	if m == nil {
+		return
+	}
+
+	m.init()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.Remove(key)
+}
+
+// Min returns the entry with the smallest key in the map.
+//
+// Returns:
+//   - K: The smallest key.
+//   - V: Its value.
+//   - bool: True if the map is non-empty. False otherwise.
+func (m *ConcurrentOrderedMap[K, V]) Min() (K, V, bool) {
+	if m == nil {
+		return *new(K), *new(V), false
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Min()
+}
+
+// Max returns the entry with the largest key in the map.
+//
+// Returns:
+//   - K: The largest key.
+//   - V: Its value.
+//   - bool: True if the map is non-empty. False otherwise.
+func (m *ConcurrentOrderedMap[K, V]) Max() (K, V, bool) {
+	if m == nil {
+		return *new(K), *new(V), false
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Max()
+}
+
+// Keys returns a copy of the keys in the map.
+//
+// Returns:
+//   - []K: The keys in the map.
+func (m *ConcurrentOrderedMap[K, V]) Keys() []K {
+	if m == nil {
+		return nil
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return append([]K(nil), m.m.keys...)
+}
+
+// Map returns a copy of the map of the values in the map.
+//
+// Returns:
+//   - map[K]V: The map of the values in the map. Nil if there are no keys.
+func (m *ConcurrentOrderedMap[K, V]) Map() map[K]V {
+	if m == nil {
+		return nil
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Map()
+}
+
+// Snapshot copies the map into a plain, non-concurrent OrderedMap.
+//
+// Returns:
+//   - *OrderedMap[K, V]: The snapshot. Never returns nil.
+func (m *ConcurrentOrderedMap[K, V]) Snapshot() *OrderedMap[K, V] {
+	result := NewOrderedMap[K, V]()
+
+	if m == nil {
+		return result
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, key := range m.m.keys {
+		result.Add(key, m.m.values[key])
+	}
+
+	return result
+}
+
+// All returns an iterator over a snapshot of the entries in the map, in key
+// order. The snapshot is taken under the map's read lock, which is
+// released before the first element is yielded, so iterating never blocks
+// concurrent writers.
+//
+// Returns:
+//   - iter.Seq2[K, V]: The iterator. Never returns nil.
+func (m *ConcurrentOrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return m.Snapshot().All()
+}
+
+// Entry is the same as All. It mirrors OrderedMap's naming.
+//
+// Returns:
+//   - iter.Seq2[K, V]: The iterator. Never returns nil.
+func (m *ConcurrentOrderedMap[K, V]) Entry() iter.Seq2[K, V] {
+	return m.All()
+}
+
+// Values returns an iterator over a snapshot of the values in the map, in
+// key order.
+//
+// Returns:
+//   - iter.Seq[V]: The iterator. Never returns nil.
+func (m *ConcurrentOrderedMap[K, V]) Values() iter.Seq[V] {
+	return m.Snapshot().Values()
+}
+
+// Floor returns the entry whose key is the largest one less than or equal
+// to key.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - K: The floor key.
+//   - V: Its value.
+//   - bool: True if such a key exists. False otherwise.
+func (m *ConcurrentOrderedMap[K, V]) Floor(key K) (K, V, bool) {
+	if m == nil {
+		return *new(K), *new(V), false
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Floor(key)
+}
+
+// Ceiling returns the entry whose key is the smallest one greater than or
+// equal to key.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - K: The ceiling key.
+//   - V: Its value.
+//   - bool: True if such a key exists. False otherwise.
+func (m *ConcurrentOrderedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	if m == nil {
+		return *new(K), *new(V), false
+	}
+
+	m.init()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Ceiling(key)
+}
+
+// PopMin removes and returns the entry with the smallest key in the map.
+// Does nothing and returns false if the receiver is nil or empty.
+//
+// Returns:
+//   - K: The smallest key.
+//   - V: Its value.
+//   - bool: True if an entry was removed. False otherwise.
+func (m *ConcurrentOrderedMap[K, V]) PopMin() (K, V, bool) {
+	if m == nil {
+		return *new(K), *new(V), false
+	}
+
+	m.init()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.m.PopMin()
+}
+
+// PopMax removes and returns the entry with the largest key in the map.
+// Does nothing and returns false if the receiver is nil or empty.
+//
+// Returns:
+//   - K: The largest key.
+//   - V: Its value.
+//   - bool: True if an entry was removed. False otherwise.
+func (m *ConcurrentOrderedMap[K, V]) PopMax() (K, V, bool) {
+	if m == nil {
+		return *new(K), *new(V), false
+	}
+
+	m.init()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.m.PopMax()
+}
+
+// Range returns an iterator over a snapshot of the entries whose keys lie
+// in the half-open interval [lo, hi), in key order.
+//
+// Parameters:
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound.
+//
+// Returns:
+//   - iter.Seq2[K, V]: The iterator. Never returns nil.
+func (m *ConcurrentOrderedMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return m.Snapshot().Range(lo, hi)
+}
+
+// Insert adds every key-value pair yielded by seq to the map, overwriting
+// the value of any key that already exists. Mirrors the stdlib maps.Insert
+// function. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - seq: The iterator of key-value pairs to insert.
+func (m *ConcurrentOrderedMap[K, V]) Insert(seq iter.Seq2[K, V]) {
+	if m == nil || seq == nil {
+		return
+	}
+
+	m.init()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.Insert(seq)
+}
+
+// Union adds all key-value pairs from another map to the map. Existing keys
+// are left untouched.
+//
+// Parameters:
+//   - other: The other map to add.
+//
+// Returns:
+//   - int: The number of key-value pairs added.
+//
+// If the receiver or 'other' is nil, then 0 is returned, always.
+func (m *ConcurrentOrderedMap[K, V]) Union(other *ConcurrentOrderedMap[K, V]) int {
+	if m == nil || other == nil {
+		return 0
+	}
+
+	otherSnap := other.Snapshot()
+
+	m.init()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.m.Union(otherSnap)
+}
+
+// UnionInPlace is the same as Union, except that it discards the count of
+// key-value pairs added.
+//
+// Parameters:
+//   - other: The other map to add.
+func (m *ConcurrentOrderedMap[K, V]) UnionInPlace(other *ConcurrentOrderedMap[K, V]) {
+	_ = m.Union(other)
+}
+
+// Intersect returns a new map containing the entries whose keys are present
+// in both the receiver and 'other'. Values are taken from the receiver.
+// Neither the receiver nor 'other' are modified.
+//
+// Parameters:
+//   - other: The other map to intersect with.
+//
+// Returns:
+//   - *ConcurrentOrderedMap[K, V]: The new map. Never returns nil.
+func (m *ConcurrentOrderedMap[K, V]) Intersect(other *ConcurrentOrderedMap[K, V]) *ConcurrentOrderedMap[K, V] {
+	result := NewConcurrentOrderedMap[K, V]()
+	result.m = m.Snapshot().Intersect(other.Snapshot())
+
+	return result
+}
+
+// IntersectInPlace removes from the receiver every entry whose key is not
+// also in 'other'. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - other: The other map to intersect with.
+func (m *ConcurrentOrderedMap[K, V]) IntersectInPlace(other *ConcurrentOrderedMap[K, V]) {
+	if m == nil {
+		return
+	}
+
+	m.init()
+
+	if other == nil {
+		m.Reset()
+		return
+	}
+
+	otherSnap := other.Snapshot()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.IntersectInPlace(otherSnap)
+}
+
+// Difference returns a new map containing the entries of the receiver whose
+// keys are not in 'other'. Neither the receiver nor 'other' are modified.
+//
+// Parameters:
+//   - other: The other map to subtract.
+//
+// Returns:
+//   - *ConcurrentOrderedMap[K, V]: The new map. Never returns nil.
+func (m *ConcurrentOrderedMap[K, V]) Difference(other *ConcurrentOrderedMap[K, V]) *ConcurrentOrderedMap[K, V] {
+	result := NewConcurrentOrderedMap[K, V]()
+	result.m = m.Snapshot().Difference(other.Snapshot())
+
+	return result
+}
+
+// DifferenceInPlace removes from the receiver every entry whose key is also
+// in 'other'. Does nothing if the receiver or 'other' is nil.
+//
+// Parameters:
+//   - other: The other map to subtract.
+func (m *ConcurrentOrderedMap[K, V]) DifferenceInPlace(other *ConcurrentOrderedMap[K, V]) {
+	if m == nil || other == nil {
+		return
+	}
+
+	otherSnap := other.Snapshot()
+
+	m.init()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.DifferenceInPlace(otherSnap)
+}
+
+// SymmetricDifference returns a new map containing the entries whose keys
+// belong to exactly one of the receiver and 'other'. Neither the receiver
+// nor 'other' are modified.
+//
+// Parameters:
+//   - other: The other map.
+//
+// Returns:
+//   - *ConcurrentOrderedMap[K, V]: The new map. Never returns nil.
+func (m *ConcurrentOrderedMap[K, V]) SymmetricDifference(other *ConcurrentOrderedMap[K, V]) *ConcurrentOrderedMap[K, V] {
+	result := NewConcurrentOrderedMap[K, V]()
+	result.m = m.Snapshot().SymmetricDifference(other.Snapshot())
+
+	return result
+}
+
+// SymmetricDifferenceInPlace replaces the contents of the receiver with the
+// symmetric difference of the receiver and 'other'. Does nothing if the
+// receiver is nil.
+//
+// Parameters:
+//   - other: The other map.
+func (m *ConcurrentOrderedMap[K, V]) SymmetricDifferenceInPlace(other *ConcurrentOrderedMap[K, V]) {
+	if m == nil {
+		return
+	}
+
+	m.init()
+	result := m.Snapshot().SymmetricDifference(other.Snapshot())
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m = result
+}
+
+// IsSubset checks whether every key of the receiver is also a key of
+// 'other'. A nil or empty receiver is a subset of any map, including nil.
+//
+// Parameters:
+//   - other: The other map.
+//
+// Returns:
+//   - bool: True if the receiver's keys are a subset of other's keys. False
+//     otherwise.
+func (m *ConcurrentOrderedMap[K, V]) IsSubset(other *ConcurrentOrderedMap[K, V]) bool {
+	return m.Snapshot().IsSubset(other.Snapshot())
+}
+
+// IsSuperset checks whether every key of 'other' is also a key of the
+// receiver.
+//
+// Parameters:
+//   - other: The other map.
+//
+// Returns:
+//   - bool: True if the receiver's keys are a superset of other's keys. False
+//     otherwise.
+func (m *ConcurrentOrderedMap[K, V]) IsSuperset(other *ConcurrentOrderedMap[K, V]) bool {
+	return other.IsSubset(m)
+}
+
+// Equals checks whether the receiver and 'other' contain exactly the same
+// keys, each mapped to an equal value (compared via reflect.DeepEqual).
+//
+// Parameters:
+//   - other: The other map.
+//
+// Returns:
+//   - bool: True if both maps contain the same entries. False otherwise.
+func (m *ConcurrentOrderedMap[K, V]) Equals(other *ConcurrentOrderedMap[K, V]) bool {
+	return m.Snapshot().Equals(other.Snapshot())
+}
+
+// MarshalJSON implements the json.Marshaler interface, using the same
+// encoding rules as OrderedMap.MarshalJSON on a snapshot of the receiver.
+func (m *ConcurrentOrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	return m.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It replaces the
+// contents of the receiver with the entries decoded from data, kept in key
+// order. Does nothing and returns nil if the receiver is nil or data is the
+// JSON literal null, per the json.Unmarshaler convention.
+func (m *ConcurrentOrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	if m == nil || string(data) == "null" {
+		return nil
+	}
+
+	m.init()
+	om := NewOrderedMap[K, V]()
+
+	err := om.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m = om
+
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface, using the same
+// encoding as OrderedMap.GobEncode on a snapshot of the receiver.
+func (m *ConcurrentOrderedMap[K, V]) GobEncode() ([]byte, error) {
+	return m.Snapshot().GobEncode()
+}
+
+// GobDecode implements the gob.GobDecoder interface. It replaces the
+// contents of the receiver with the entries decoded from data, preserving
+// their order. Does nothing and returns nil if the receiver is nil.
+func (m *ConcurrentOrderedMap[K, V]) GobDecode(data []byte) error {
+	if m == nil {
+		return nil
+	}
+
+	m.init()
+	om := NewOrderedMap[K, V]()
+
+	err := om.GobDecode(data)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m = om
+
+	return nil
+}
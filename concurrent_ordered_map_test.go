@@ -0,0 +1,74 @@
+package sets
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestConcurrentOrderedMapZeroValue checks that a zero-value
+// ConcurrentOrderedMap (not constructed via NewConcurrentOrderedMap) is
+// safe to use directly, including the methods that previously dereferenced
+// a nil underlying OrderedMap.
+func TestConcurrentOrderedMapZeroValue(t *testing.T) {
+	var m ConcurrentOrderedMap[int, string]
+
+	if !m.IsEmpty() {
+		t.Fatalf("IsEmpty() on zero-value map should be true")
+	}
+
+	m.Add(1, "a")
+
+	if m.Size() != 1 {
+		t.Fatalf("Size() = %d, want 1", m.Size())
+	}
+
+	value, ok := m.Get(1)
+	if !ok || value != "a" {
+		t.Fatalf("Get(1) = (%q, %v), want (\"a\", true)", value, ok)
+	}
+
+	key, value, ok := m.Min()
+	if !ok || key != 1 || value != "a" {
+		t.Fatalf("Min() = (%d, %q, %v), want (1, \"a\", true)", key, value, ok)
+	}
+
+	m.Remove(1)
+
+	if !m.IsEmpty() {
+		t.Fatalf("IsEmpty() should be true after removing the only entry")
+	}
+}
+
+// TestConcurrentOrderedMapConcurrent exercises a zero-value
+// ConcurrentOrderedMap from many goroutines at once. Run with -race to
+// catch data races.
+func TestConcurrentOrderedMapConcurrent(t *testing.T) {
+	var m ConcurrentOrderedMap[int, int]
+
+	const goroutines = 32
+	const perGoroutine = 100
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+
+	for g := 0; g < goroutines; g++ {
+		go func(base int) {
+			defer wg.Done()
+
+			for i := 0; i < perGoroutine; i++ {
+				key := base*perGoroutine + i
+				m.Add(key, key)
+
+				if value, ok := m.Get(key); !ok || value != key {
+					t.Errorf("Get(%d) = (%d, %v), want (%d, true)", key, value, ok, key)
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	if got, want := m.Size(), goroutines*perGoroutine; got != want {
+		t.Fatalf("Size() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,672 @@
+package sets
+
+import (
+	"hash/maphash"
+	"iter"
+	"sync"
+
+	"github.com/PlayerR9/go-sets/internal"
+)
+
+// defaultConcurrentSeenShards is the shard count used when
+// NewConcurrentSeenSet is given a non-positive value.
+const defaultConcurrentSeenShards = 16
+
+// concurrentSeenShard is a single shard of a ConcurrentSeenSet: an
+// independently-locked table of seen values.
+type concurrentSeenShard[T comparable] struct {
+	mu    sync.RWMutex
+	table map[T]struct{}
+}
+
+// ConcurrentSeenSet is a concurrency-safe variant of SeenSet. Values are
+// hashed into one of a fixed, power-of-two number of shards, each guarded
+// by its own sync.RWMutex, so that reads and writes to unrelated values do
+// not contend with one another.
+//
+// The zero value is a valid, empty ConcurrentSeenSet with
+// defaultConcurrentSeenShards shards: it lazily allocates its shard table
+// on first use, so it does not have to be constructed via
+// NewConcurrentSeenSet.
+type ConcurrentSeenSet[T comparable] struct {
+	once   sync.Once
+	shards []*concurrentSeenShard[T]
+	seed   maphash.Seed
+	mask   uint64
+}
+
+// NewConcurrentSeenSet creates a new ConcurrentSeenSet with the given
+// number of shards, rounded up to the next power of two. Non-positive
+// values fall back to defaultConcurrentSeenShards.
+//
+// Parameters:
+//   - shards: The desired number of shards.
+//
+// Returns:
+//   - *ConcurrentSeenSet[T]: The created set. Never returns nil.
+func NewConcurrentSeenSet[T comparable](shards int) *ConcurrentSeenSet[T] {
+	if shards <= 0 {
+		shards = defaultConcurrentSeenShards
+	}
+
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+
+	table := make([]*concurrentSeenShard[T], n)
+	for i := range table {
+		table[i] = &concurrentSeenShard[T]{
+			table: make(map[T]struct{}),
+		}
+	}
+
+	cs := &ConcurrentSeenSet[T]{
+		shards: table,
+		seed:   maphash.MakeSeed(),
+		mask:   uint64(n - 1),
+	}
+
+	// Mark initialization as already done, so a later call to init (via any
+	// method call on cs) is a no-op instead of discarding the shard table
+	// just built above.
+	cs.once.Do(func() {})
+
+	return cs
+}
+
+// init lazily allocates the shard table with defaultConcurrentSeenShards
+// shards, so that a zero-value ConcurrentSeenSet is safe to use without
+// going through NewConcurrentSeenSet. Safe to call concurrently and safe
+// to call on every method entry: it does nothing past the first call.
+func (cs *ConcurrentSeenSet[T]) init() {
+	cs.once.Do(func() {
+		n := defaultConcurrentSeenShards
+
+		table := make([]*concurrentSeenShard[T], n)
+		for i := range table {
+			table[i] = &concurrentSeenShard[T]{
+				table: make(map[T]struct{}),
+			}
+		}
+
+		cs.shards = table
+		cs.seed = maphash.MakeSeed()
+		cs.mask = uint64(n - 1)
+	})
+}
+
+// shardFor returns the shard responsible for v.
+func (cs *ConcurrentSeenSet[T]) shardFor(v T) *concurrentSeenShard[T] {
+	h := maphash.Comparable(cs.seed, v)
+	return cs.shards[h&cs.mask]
+}
+
+// IsEmpty implements the Set interface.
+func (cs *ConcurrentSeenSet[T]) IsEmpty() bool {
+	if cs == nil {
+		return true
+	}
+
+	return cs.Size() == 0
+}
+
+// Size implements the Set interface. It acquires a read lock on every
+// shard in turn; the result is a best-effort count if the set is mutated
+// concurrently.
+func (cs *ConcurrentSeenSet[T]) Size() int {
+	if cs == nil {
+		return 0
+	}
+
+	cs.init()
+
+	var total int
+
+	for _, shard := range cs.shards {
+		shard.mu.RLock()
+		total += len(shard.table)
+		shard.mu.RUnlock()
+	}
+
+	return total
+}
+
+// Reset implements the Set interface.
+func (cs *ConcurrentSeenSet[T]) Reset() {
+	if cs == nil {
+		return
+	}
+
+	cs.init()
+
+	for _, shard := range cs.shards {
+		shard.mu.Lock()
+		for v := range shard.table {
+			delete(shard.table, v)
+		}
+		shard.mu.Unlock()
+	}
+}
+
+// See sets the value as seen.
+//
+// Parameters:
+//   - v: The value to set as seen.
+//
+// Returns:
+//   - bool: True if the value was set as seen. False if it was already
+//     seen or the receiver is nil.
+func (cs *ConcurrentSeenSet[T]) See(v T) bool {
+	if cs == nil {
+		return false
+	}
+
+	return cs.GetOrAdd(v)
+}
+
+// SetSeen sets the value as seen. Does nothing if the receiver is nil or
+// the value is already seen.
+//
+// Parameters:
+//   - v: The value to set as seen.
+func (cs *ConcurrentSeenSet[T]) SetSeen(v T) {
+	if cs == nil {
+		return
+	}
+
+	_ = cs.GetOrAdd(v)
+}
+
+// Has checks whether the value is seen.
+//
+// Parameters:
+//   - v: The value to check.
+//
+// Returns:
+//   - bool: True if the value is seen, false otherwise.
+func (cs *ConcurrentSeenSet[T]) Has(v T) bool {
+	if cs == nil {
+		return false
+	}
+
+	cs.init()
+
+	shard := cs.shardFor(v)
+
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	_, ok := shard.table[v]
+	return ok
+}
+
+// GetOrAdd sets v as seen if it is not already, atomically with respect to
+// the shard v hashes to.
+//
+// Parameters:
+//   - v: The value to set as seen.
+//
+// Returns:
+//   - bool: True if v was not seen before and has now been added. False if
+//     it was already seen or the receiver is nil.
+func (cs *ConcurrentSeenSet[T]) GetOrAdd(v T) (added bool) {
+	if cs == nil {
+		return false
+	}
+
+	cs.init()
+
+	shard := cs.shardFor(v)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	_, ok := shard.table[v]
+	if ok {
+		return false
+	}
+
+	shard.table[v] = struct{}{}
+	return true
+}
+
+// CompareAndDelete removes v from the set if it is present, atomically
+// with respect to the shard v hashes to.
+//
+// Parameters:
+//   - v: The value to remove.
+//
+// Returns:
+//   - bool: True if v was present and has now been removed. False
+//     otherwise.
+func (cs *ConcurrentSeenSet[T]) CompareAndDelete(v T) bool {
+	if cs == nil {
+		return false
+	}
+
+	cs.init()
+
+	shard := cs.shardFor(v)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	_, ok := shard.table[v]
+	if !ok {
+		return false
+	}
+
+	delete(shard.table, v)
+	return true
+}
+
+// Snapshot copies the set into a plain, non-concurrent SeenSet.
+//
+// Returns:
+//   - *SeenSet[T]: The snapshot. Never returns nil.
+func (cs *ConcurrentSeenSet[T]) Snapshot() *SeenSet[T] {
+	result := NewSeenSet[T]()
+
+	if cs == nil {
+		return result
+	}
+
+	cs.init()
+
+	for _, shard := range cs.shards {
+		shard.mu.RLock()
+		for v := range shard.table {
+			result.SetSeen(v)
+		}
+		shard.mu.RUnlock()
+	}
+
+	return result
+}
+
+// All returns an iterator over every seen value. It acquires a read lock on
+// one shard at a time, releasing it before moving to the next, so it never
+// holds more than one shard locked at once. The order of iteration is
+// unspecified.
+//
+// Returns:
+//   - iter.Seq[T]: The iterator. Never returns nil.
+func (cs *ConcurrentSeenSet[T]) All() iter.Seq[T] {
+	var fn func(yield func(T) bool)
+
+	if cs == nil {
+		fn = func(yield func(T) bool) {}
+	} else {
+		cs.init()
+
+		fn = func(yield func(T) bool) {
+			for _, shard := range cs.shards {
+				shard.mu.RLock()
+
+				for v := range shard.table {
+					if !yield(v) {
+						shard.mu.RUnlock()
+						return
+					}
+				}
+
+				shard.mu.RUnlock()
+			}
+		}
+	}
+
+	return fn
+}
+
+// shardCount returns the number of shards used by the receiver, or
+// defaultConcurrentSeenShards if the receiver has none (including nil).
+func (cs *ConcurrentSeenSet[T]) shardCount() int {
+	if cs == nil || len(cs.shards) == 0 {
+		return defaultConcurrentSeenShards
+	}
+
+	return len(cs.shards)
+}
+
+// Union adds all values from another set to the set.
+//
+// Parameters:
+//   - other: The other set to add.
+//
+// Returns:
+//   - int: The number of values added.
+//
+// If the receiver or 'other' is nil, then 0 is returned, always.
+func (cs *ConcurrentSeenSet[T]) Union(other *ConcurrentSeenSet[T]) int {
+	if cs == nil || other == nil {
+		return 0
+	}
+
+	var count int
+
+	for v := range other.Snapshot().All() {
+		if cs.GetOrAdd(v) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// UnionInPlace is the same as Union, except that it discards the count of
+// values added.
+//
+// Parameters:
+//   - other: The other set to add.
+func (cs *ConcurrentSeenSet[T]) UnionInPlace(other *ConcurrentSeenSet[T]) {
+	_ = cs.Union(other)
+}
+
+// Intersect returns a new set containing the values that are present in both
+// the receiver and 'other'. Neither the receiver nor 'other' are modified.
+//
+// Parameters:
+//   - other: The other set to intersect with.
+//
+// Returns:
+//   - *ConcurrentSeenSet[T]: The new set, sharded like the receiver. Never
+//     returns nil.
+func (cs *ConcurrentSeenSet[T]) Intersect(other *ConcurrentSeenSet[T]) *ConcurrentSeenSet[T] {
+	result := NewConcurrentSeenSet[T](cs.shardCount())
+
+	if cs == nil || other == nil {
+		return result
+	}
+
+	for v := range cs.Snapshot().All() {
+		if other.Has(v) {
+			result.SetSeen(v)
+		}
+	}
+
+	return result
+}
+
+// IntersectInPlace removes from the receiver every value that is not also in
+// 'other'. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - other: The other set to intersect with.
+func (cs *ConcurrentSeenSet[T]) IntersectInPlace(other *ConcurrentSeenSet[T]) {
+	if cs == nil {
+		return
+	}
+
+	if other == nil {
+		cs.Reset()
+		return
+	}
+
+	for v := range cs.Snapshot().All() {
+		if !other.Has(v) {
+			cs.CompareAndDelete(v)
+		}
+	}
+}
+
+// Difference returns a new set containing the values that are in the
+// receiver but not in 'other'. Neither the receiver nor 'other' are
+// modified.
+//
+// Parameters:
+//   - other: The other set to subtract.
+//
+// Returns:
+//   - *ConcurrentSeenSet[T]: The new set, sharded like the receiver. Never
+//     returns nil.
+func (cs *ConcurrentSeenSet[T]) Difference(other *ConcurrentSeenSet[T]) *ConcurrentSeenSet[T] {
+	result := NewConcurrentSeenSet[T](cs.shardCount())
+
+	if cs == nil {
+		return result
+	}
+
+	for v := range cs.Snapshot().All() {
+		if other == nil || !other.Has(v) {
+			result.SetSeen(v)
+		}
+	}
+
+	return result
+}
+
+// DifferenceInPlace removes from the receiver every value that is also in
+// 'other'. Does nothing if the receiver or 'other' is nil.
+//
+// Parameters:
+//   - other: The other set to subtract.
+func (cs *ConcurrentSeenSet[T]) DifferenceInPlace(other *ConcurrentSeenSet[T]) {
+	if cs == nil || other == nil {
+		return
+	}
+
+	for v := range other.Snapshot().All() {
+		cs.CompareAndDelete(v)
+	}
+}
+
+// SymmetricDifference returns a new set containing the values that belong to
+// exactly one of the receiver and 'other'. Neither the receiver nor 'other'
+// are modified.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - *ConcurrentSeenSet[T]: The new set, sharded like the receiver. Never
+//     returns nil.
+func (cs *ConcurrentSeenSet[T]) SymmetricDifference(other *ConcurrentSeenSet[T]) *ConcurrentSeenSet[T] {
+	result := NewConcurrentSeenSet[T](cs.shardCount())
+
+	if cs != nil {
+		for v := range cs.Snapshot().All() {
+			if other == nil || !other.Has(v) {
+				result.SetSeen(v)
+			}
+		}
+	}
+
+	if other != nil {
+		for v := range other.Snapshot().All() {
+			if cs == nil || !cs.Has(v) {
+				result.SetSeen(v)
+			}
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifferenceInPlace replaces the contents of the receiver with the
+// symmetric difference of the receiver and 'other'. Does nothing if the
+// receiver is nil.
+//
+// Parameters:
+//   - other: The other set.
+func (cs *ConcurrentSeenSet[T]) SymmetricDifferenceInPlace(other *ConcurrentSeenSet[T]) {
+	if cs == nil {
+		return
+	}
+
+	result := cs.SymmetricDifference(other)
+
+	cs.Reset()
+
+	for v := range result.All() {
+		cs.SetSeen(v)
+	}
+}
+
+// IsSubset checks whether every value of the receiver is also a value of
+// 'other'. A nil or empty receiver is a subset of any set, including nil.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - bool: True if the receiver is a subset of 'other'. False otherwise.
+func (cs *ConcurrentSeenSet[T]) IsSubset(other *ConcurrentSeenSet[T]) bool {
+	if cs == nil || cs.Size() == 0 {
+		return true
+	}
+
+	if other == nil {
+		return false
+	}
+
+	for v := range cs.Snapshot().All() {
+		if !other.Has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset checks whether every value of 'other' is also a value of the
+// receiver.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - bool: True if the receiver is a superset of 'other'. False otherwise.
+func (cs *ConcurrentSeenSet[T]) IsSuperset(other *ConcurrentSeenSet[T]) bool {
+	return other.IsSubset(cs)
+}
+
+// Equals checks whether the receiver and 'other' contain exactly the same
+// values.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - bool: True if both sets contain the same values. False otherwise.
+func (cs *ConcurrentSeenSet[T]) Equals(other *ConcurrentSeenSet[T]) bool {
+	return cs.Size() == other.Size() && cs.IsSubset(other)
+}
+
+// Insert sets every value yielded by seq as seen. Mirrors the stdlib
+// maps.Insert helper. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - seq: The iterator of values to insert.
+func (cs *ConcurrentSeenSet[T]) Insert(seq iter.Seq[T]) {
+	if cs == nil || seq == nil {
+		return
+	}
+
+	for v := range seq {
+		cs.SetSeen(v)
+	}
+}
+
+// FilterSeen returns the elements that are seen. The order of the elements is
+// preserved and no duplicates are contained.
+//
+// Parameters:
+//   - elems: The elements to filter.
+//
+// Returns:
+//   - []T: The elements that are seen.
+func (cs *ConcurrentSeenSet[T]) FilterSeen(elems []T) []T {
+	slice := make([]T, 0, len(elems))
+
+	for i := 0; i < len(elems); i++ {
+		if cs.Has(elems[i]) {
+			slice = append(slice, elems[i])
+		}
+	}
+
+	slice = internal.Unique(slice)
+	return slice
+}
+
+// FilterNotSeen is like FilterSeen but returns the elements that are not
+// seen.
+//
+// Parameters:
+//   - elems: The elements to filter.
+//
+// Returns:
+//   - []T: The elements that are not seen.
+func (cs *ConcurrentSeenSet[T]) FilterNotSeen(elems []T) []T {
+	slice := make([]T, 0, len(elems))
+
+	for i := 0; i < len(elems); i++ {
+		if !cs.Has(elems[i]) {
+			slice = append(slice, elems[i])
+		}
+	}
+
+	slice = internal.Unique(slice)
+	return slice
+}
+
+// MarshalJSON implements the json.Marshaler interface. The set is encoded
+// as a JSON array, using the same ordering rules as SeenSet.MarshalJSON.
+func (cs *ConcurrentSeenSet[T]) MarshalJSON() ([]byte, error) {
+	return cs.Snapshot().MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It replaces the
+// contents of the receiver with the values decoded from a JSON array. Does
+// nothing and returns nil if the receiver is nil or data is the JSON literal
+// null, per the json.Unmarshaler convention.
+func (cs *ConcurrentSeenSet[T]) UnmarshalJSON(data []byte) error {
+	if cs == nil || string(data) == "null" {
+		return nil
+	}
+
+	var sm SeenSet[T]
+
+	err := sm.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	cs.Reset()
+
+	for v := range sm.All() {
+		cs.SetSeen(v)
+	}
+
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface. The set is encoded as
+// a gob-encoded slice, in the same order as MarshalJSON.
+func (cs *ConcurrentSeenSet[T]) GobEncode() ([]byte, error) {
+	return cs.Snapshot().GobEncode()
+}
+
+// GobDecode implements the gob.GobDecoder interface. It replaces the
+// contents of the receiver with the values decoded from data. Does nothing
+// and returns nil if the receiver is nil.
+func (cs *ConcurrentSeenSet[T]) GobDecode(data []byte) error {
+	if cs == nil {
+		return nil
+	}
+
+	var sm SeenSet[T]
+
+	err := sm.GobDecode(data)
+	if err != nil {
+		return err
+	}
+
+	cs.Reset()
+
+	for v := range sm.All() {
+		cs.SetSeen(v)
+	}
+
+	return nil
+}
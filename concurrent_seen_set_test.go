@@ -0,0 +1,70 @@
+package sets
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestConcurrentSeenSetZeroValue checks that a zero-value ConcurrentSeenSet
+// (not constructed via NewConcurrentSeenSet) is safe to use directly,
+// including the methods that previously indexed into a nil shard table.
+func TestConcurrentSeenSetZeroValue(t *testing.T) {
+	var cs ConcurrentSeenSet[int]
+
+	if cs.Has(1) {
+		t.Fatalf("Has on empty zero-value set returned true")
+	}
+
+	if !cs.See(1) {
+		t.Fatalf("first See(1) should report added")
+	}
+
+	if !cs.Has(1) {
+		t.Fatalf("Has(1) should be true after See(1)")
+	}
+
+	if cs.Size() != 1 {
+		t.Fatalf("Size() = %d, want 1", cs.Size())
+	}
+
+	if !cs.CompareAndDelete(1) {
+		t.Fatalf("CompareAndDelete(1) should report removed")
+	}
+
+	if cs.Size() != 0 {
+		t.Fatalf("Size() = %d, want 0 after CompareAndDelete", cs.Size())
+	}
+}
+
+// TestConcurrentSeenSetConcurrent exercises a zero-value ConcurrentSeenSet
+// from many goroutines at once. Run with -race to catch data races.
+func TestConcurrentSeenSetConcurrent(t *testing.T) {
+	var cs ConcurrentSeenSet[int]
+
+	const goroutines = 32
+	const perGoroutine = 100
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+
+	for g := 0; g < goroutines; g++ {
+		go func(base int) {
+			defer wg.Done()
+
+			for i := 0; i < perGoroutine; i++ {
+				v := base*perGoroutine + i
+				cs.SetSeen(v)
+
+				if !cs.Has(v) {
+					t.Errorf("Has(%d) = false right after SetSeen", v)
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	if got, want := cs.Size(), goroutines*perGoroutine; got != want {
+		t.Fatalf("Size() = %d, want %d", got, want)
+	}
+}
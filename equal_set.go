@@ -1,16 +1,24 @@
 package sets
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"iter"
 	"slices"
 )
 
+// Equaler is implemented by types that can compare themselves against
+// another value of the same type for equality. It is the constraint
+// required by EqualSet.
+type Equaler[T any] interface {
+	Equals(other T) bool
+}
+
 // EqualSet represents a set of elements that implements the Equals method.
 //
 // Interface: Equals(other T) bool
-type EqualSet[T interface {
-	Equals(other T) bool
-}] struct {
+type EqualSet[T Equaler[T]] struct {
 	// elems is the set of elements
 	elems []T
 }
@@ -43,9 +51,7 @@ func (s *EqualSet[T]) Reset() {
 //
 // Returns:
 //   - *EqualSet[T]: The created set. Never returns nil.
-func NewEqualSet[T interface {
-	Equals(other T) bool
-}]() *EqualSet[T] {
+func NewEqualSet[T Equaler[T]]() *EqualSet[T] {
 	return &EqualSet[T]{
 		elems: make([]T, 0),
 	}
@@ -111,6 +117,233 @@ func (s *EqualSet[T]) Union(other *EqualSet[T]) int {
 	return count
 }
 
+// UnionInPlace is the same as Union, except that it discards the count of
+// elements added.
+//
+// Parameters:
+//   - other: The other set to add.
+func (s *EqualSet[T]) UnionInPlace(other *EqualSet[T]) {
+	_ = s.Union(other)
+}
+
+// Intersect returns a new set containing the elements that are present in
+// both the receiver and 'other'. Neither the receiver nor 'other' are
+// modified.
+//
+// Parameters:
+//   - other: The other set to intersect with.
+//
+// Returns:
+//   - *EqualSet[T]: The new set. Never returns nil.
+func (s *EqualSet[T]) Intersect(other *EqualSet[T]) *EqualSet[T] {
+	result := NewEqualSet[T]()
+
+	if s == nil || other == nil {
+		return result
+	}
+
+	for _, elem := range s.elems {
+		if slices.ContainsFunc(other.elems, elem.Equals) {
+			result.elems = append(result.elems, elem)
+		}
+	}
+
+	return result
+}
+
+// IntersectInPlace removes from the receiver every element that is not also
+// in 'other'. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - other: The other set to intersect with.
+func (s *EqualSet[T]) IntersectInPlace(other *EqualSet[T]) {
+	if s == nil || len(s.elems) == 0 {
+		return
+	}
+
+	if other == nil {
+		s.elems = s.elems[:0]
+		return
+	}
+
+	top := 0
+
+	for i := 0; i < len(s.elems); i++ {
+		if slices.ContainsFunc(other.elems, s.elems[i].Equals) {
+			s.elems[top] = s.elems[i]
+			top++
+		}
+	}
+
+	s.elems = s.elems[:top]
+}
+
+// Difference returns a new set containing the elements that are in the
+// receiver but not in 'other'. Neither the receiver nor 'other' are
+// modified.
+//
+// Parameters:
+//   - other: The other set to subtract.
+//
+// Returns:
+//   - *EqualSet[T]: The new set. Never returns nil.
+func (s *EqualSet[T]) Difference(other *EqualSet[T]) *EqualSet[T] {
+	result := NewEqualSet[T]()
+
+	if s == nil {
+		return result
+	}
+
+	if other == nil {
+		result.elems = append(result.elems, s.elems...)
+		return result
+	}
+
+	for _, elem := range s.elems {
+		if !slices.ContainsFunc(other.elems, elem.Equals) {
+			result.elems = append(result.elems, elem)
+		}
+	}
+
+	return result
+}
+
+// DifferenceInPlace removes from the receiver every element that is also in
+// 'other'. Does nothing if the receiver or 'other' is nil.
+//
+// Parameters:
+//   - other: The other set to subtract.
+func (s *EqualSet[T]) DifferenceInPlace(other *EqualSet[T]) {
+	if s == nil || len(s.elems) == 0 || other == nil {
+		return
+	}
+
+	top := 0
+
+	for i := 0; i < len(s.elems); i++ {
+		if !slices.ContainsFunc(other.elems, s.elems[i].Equals) {
+			s.elems[top] = s.elems[i]
+			top++
+		}
+	}
+
+	s.elems = s.elems[:top]
+}
+
+// SymmetricDifference returns a new set containing the elements that belong
+// to exactly one of the receiver and 'other'. Neither the receiver nor
+// 'other' are modified.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - *EqualSet[T]: The new set. Never returns nil.
+func (s *EqualSet[T]) SymmetricDifference(other *EqualSet[T]) *EqualSet[T] {
+	result := NewEqualSet[T]()
+
+	if s == nil && other == nil {
+		return result
+	}
+
+	if s == nil {
+		result.elems = append(result.elems, other.elems...)
+		return result
+	}
+
+	if other == nil {
+		result.elems = append(result.elems, s.elems...)
+		return result
+	}
+
+	for _, elem := range s.elems {
+		if !slices.ContainsFunc(other.elems, elem.Equals) {
+			result.elems = append(result.elems, elem)
+		}
+	}
+
+	for _, elem := range other.elems {
+		if !slices.ContainsFunc(s.elems, elem.Equals) {
+			result.elems = append(result.elems, elem)
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifferenceInPlace replaces the contents of the receiver with the
+// symmetric difference of the receiver and 'other'. Does nothing if the
+// receiver is nil.
+//
+// Parameters:
+//   - other: The other set.
+func (s *EqualSet[T]) SymmetricDifferenceInPlace(other *EqualSet[T]) {
+	if s == nil {
+		return
+	}
+
+	s.elems = s.SymmetricDifference(other).elems
+}
+
+// IsSubset checks whether every element of the receiver is also an element
+// of 'other'. A nil or empty receiver is a subset of any set, including nil.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - bool: True if the receiver is a subset of 'other'. False otherwise.
+func (s *EqualSet[T]) IsSubset(other *EqualSet[T]) bool {
+	if s == nil || len(s.elems) == 0 {
+		return true
+	}
+
+	if other == nil {
+		return false
+	}
+
+	for _, elem := range s.elems {
+		if !slices.ContainsFunc(other.elems, elem.Equals) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset checks whether every element of 'other' is also an element of
+// the receiver.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - bool: True if the receiver is a superset of 'other'. False otherwise.
+func (s *EqualSet[T]) IsSuperset(other *EqualSet[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equals checks whether the receiver and 'other' contain exactly the same
+// elements, regardless of order.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - bool: True if both sets contain the same elements. False otherwise.
+func (s *EqualSet[T]) Equals(other *EqualSet[T]) bool {
+	var s_len, other_len int
+
+	if s != nil {
+		s_len = len(s.elems)
+	}
+	if other != nil {
+		other_len = len(other.elems)
+	}
+
+	return s_len == other_len && s.IsSubset(other)
+}
+
 // All returns an iterator that iterates over all elements in the set.
 //
 // Returns:
@@ -132,3 +365,100 @@ func (s *EqualSet[T]) All() iter.Seq[T] {
 
 	return fn
 }
+
+// MarshalJSON implements the json.Marshaler interface. The set is encoded
+// as a JSON array, in insertion order.
+func (s EqualSet[T]) MarshalJSON() ([]byte, error) {
+	elems := s.elems
+	if elems == nil {
+		elems = []T{}
+	}
+
+	return json.Marshal(elems)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It replaces the
+// contents of the receiver with the elements decoded from a JSON array. Does
+// nothing and returns nil if the receiver is nil or data is the JSON literal
+// null, per the json.Unmarshaler convention.
+func (s *EqualSet[T]) UnmarshalJSON(data []byte) error {
+	if s == nil || string(data) == "null" {
+		return nil
+	}
+
+	var elems []T
+
+	err := json.Unmarshal(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	s.Reset()
+	s.AddMany(elems)
+
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface. The set is encoded as
+// a gob-encoded slice, in insertion order.
+func (s EqualSet[T]) GobEncode() ([]byte, error) {
+	elems := s.elems
+	if elems == nil {
+		elems = []T{}
+	}
+
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(elems)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface. It replaces the
+// contents of the receiver with the elements decoded from data.
+func (s *EqualSet[T]) GobDecode(data []byte) error {
+	var elems []T
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elems)
+	if err != nil {
+		return err
+	}
+
+	s.Reset()
+	s.AddMany(elems)
+
+	return nil
+}
+
+// Insert adds every element yielded by seq to the set. Mirrors the stdlib
+// maps.Insert helper. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - seq: The iterator of elements to insert.
+func (s *EqualSet[T]) Insert(seq iter.Seq[T]) {
+	if s == nil || seq == nil {
+		return
+	}
+
+	for elem := range seq {
+		s.Add(elem)
+	}
+}
+
+// CollectEqual builds a new EqualSet out of the elements yielded by seq.
+// Mirrors the stdlib maps.Collect helper.
+//
+// Parameters:
+//   - seq: The iterator of elements to collect.
+//
+// Returns:
+//   - *EqualSet[T]: The new set. Never returns nil.
+func CollectEqual[T Equaler[T]](seq iter.Seq[T]) *EqualSet[T] {
+	s := NewEqualSet[T]()
+	s.Insert(seq)
+
+	return s
+}
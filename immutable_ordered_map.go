@@ -0,0 +1,322 @@
+package sets
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+// treapNode is a node of the persistent treap backing ImmutableOrderedMap.
+// Every mutation allocates new nodes only along the root-to-leaf path (plus,
+// occasionally, the O(1) nodes touched by a rotation); every other subtree
+// is shared by pointer with the previous version.
+type treapNode[K cmp.Ordered, V any] struct {
+	key      K
+	value    V
+	priority uint64
+	size     int
+	left     *treapNode[K, V]
+	right    *treapNode[K, V]
+}
+
+// newTreapNode creates a node and computes its size from its children.
+func newTreapNode[K cmp.Ordered, V any](key K, value V, priority uint64, left, right *treapNode[K, V]) *treapNode[K, V] {
+	return &treapNode[K, V]{
+		key:      key,
+		value:    value,
+		priority: priority,
+		size:     1 + treapSize(left) + treapSize(right),
+		left:     left,
+		right:    right,
+	}
+}
+
+// treapSize returns the size of n, treating a nil node as empty.
+func treapSize[K cmp.Ordered, V any](n *treapNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+// treapGet looks up key in the treap rooted at n.
+func treapGet[K cmp.Ordered, V any](n *treapNode[K, V], key K) (V, bool) {
+	for n != nil {
+		switch {
+		case key < n.key:
+			n = n.left
+		case key > n.key:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+
+	return *new(V), false
+}
+
+// treapInsert returns a new treap with key bound to value, preserving the
+// heap-order invariant on priority via rotations. Only the nodes on the
+// search path (and any rotated nodes) are reallocated.
+func treapInsert[K cmp.Ordered, V any](n *treapNode[K, V], key K, value V, priority uint64) *treapNode[K, V] {
+	if n == nil {
+		return newTreapNode(key, value, priority, nil, nil)
+	}
+
+	switch {
+	case key == n.key:
+		return newTreapNode(key, value, n.priority, n.left, n.right)
+	case key < n.key:
+		new_left := treapInsert(n.left, key, value, priority)
+		if new_left.priority <= n.priority {
+			return newTreapNode(n.key, n.value, n.priority, new_left, n.right)
+		}
+
+		// Rotate right: new_left's priority wins, so it becomes the root of
+		// this subtree.
+		return newTreapNode(new_left.key, new_left.value, new_left.priority, new_left.left,
+			newTreapNode(n.key, n.value, n.priority, new_left.right, n.right))
+	default:
+		new_right := treapInsert(n.right, key, value, priority)
+		if new_right.priority <= n.priority {
+			return newTreapNode(n.key, n.value, n.priority, n.left, new_right)
+		}
+
+		// Rotate left: new_right's priority wins, so it becomes the root of
+		// this subtree.
+		return newTreapNode(new_right.key, new_right.value, new_right.priority,
+			newTreapNode(n.key, n.value, n.priority, n.left, new_right.left), new_right.right)
+	}
+}
+
+// treapJoin merges two treaps known to be key-disjoint ranges, where every
+// key in left is less than every key in right.
+func treapJoin[K cmp.Ordered, V any](left, right *treapNode[K, V]) *treapNode[K, V] {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+
+	if left.priority > right.priority {
+		return newTreapNode(left.key, left.value, left.priority, left.left, treapJoin(left.right, right))
+	}
+
+	return newTreapNode(right.key, right.value, right.priority, treapJoin(left, right.left), right.right)
+}
+
+// treapDelete returns a new treap with key removed, if present.
+func treapDelete[K cmp.Ordered, V any](n *treapNode[K, V], key K) *treapNode[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	switch {
+	case key < n.key:
+		return newTreapNode(n.key, n.value, n.priority, treapDelete(n.left, key), n.right)
+	case key > n.key:
+		return newTreapNode(n.key, n.value, n.priority, n.left, treapDelete(n.right, key))
+	default:
+		return treapJoin(n.left, n.right)
+	}
+}
+
+// treapAll yields every entry of the treap rooted at n, in key order. It
+// returns false as soon as yield does, so callers can propagate early exit.
+func treapAll[K cmp.Ordered, V any](n *treapNode[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	if !treapAll(n.left, yield) {
+		return false
+	}
+
+	if !yield(n.key, n.value) {
+		return false
+	}
+
+	return treapAll(n.right, yield)
+}
+
+// ImmutableOrderedMap is a persistent, immutable map ordered by its keys.
+// It is backed by a treap: every Set, Delete, or Insert returns a new map
+// that shares most of its structure with the receiver instead of copying
+// it, making it cheap to keep many versions around (undo history,
+// transactional snapshots, parser backtracking, ...).
+//
+// The zero value is the empty map, the same value NewImmutableOrderedMap
+// returns. A nil *ImmutableOrderedMap is treated as the empty map by every
+// method.
+type ImmutableOrderedMap[K cmp.Ordered, V any] struct {
+	// root is the root of the treap. Nil means the map is empty.
+	root *treapNode[K, V]
+}
+
+// NewImmutableOrderedMap creates a new empty ImmutableOrderedMap.
+//
+// Returns:
+//   - *ImmutableOrderedMap[K, V]: The created map. Never returns nil.
+func NewImmutableOrderedMap[K cmp.Ordered, V any]() *ImmutableOrderedMap[K, V] {
+	return &ImmutableOrderedMap[K, V]{}
+}
+
+// Len returns the number of entries in the map.
+//
+// Returns:
+//   - int: The number of entries. Never returns a negative number.
+func (m *ImmutableOrderedMap[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+
+	return treapSize(m.root)
+}
+
+// Get returns the value bound to key in the map.
+//
+// Parameters:
+//   - key: The key to look up.
+//
+// Returns:
+//   - V: The value bound to key.
+//   - bool: True if key is present. False otherwise.
+func (m *ImmutableOrderedMap[K, V]) Get(key K) (V, bool) {
+	if m == nil {
+		return *new(V), false
+	}
+
+	return treapGet(m.root, key)
+}
+
+// Set returns a new map with key bound to value, leaving the receiver
+// unchanged. A nil receiver is treated as the empty map.
+//
+// Parameters:
+//   - key: The key to bind.
+//   - value: The value to bind it to.
+//
+// Returns:
+//   - *ImmutableOrderedMap[K, V]: The new map. Never returns nil.
+func (m *ImmutableOrderedMap[K, V]) Set(key K, value V) *ImmutableOrderedMap[K, V] {
+	var root *treapNode[K, V]
+
+	if m != nil {
+		root = m.root
+	}
+
+	return &ImmutableOrderedMap[K, V]{
+		root: treapInsert(root, key, value, rand.Uint64()),
+	}
+}
+
+// Delete returns a new map with key removed, leaving the receiver
+// unchanged. A nil receiver is treated as the empty map.
+//
+// Parameters:
+//   - key: The key to remove.
+//
+// Returns:
+//   - *ImmutableOrderedMap[K, V]: The new map. Never returns nil.
+func (m *ImmutableOrderedMap[K, V]) Delete(key K) *ImmutableOrderedMap[K, V] {
+	if m == nil {
+		return NewImmutableOrderedMap[K, V]()
+	}
+
+	return &ImmutableOrderedMap[K, V]{
+		root: treapDelete(m.root, key),
+	}
+}
+
+// Insert returns a new map with every key-value pair yielded by seq bound,
+// later pairs overwriting earlier ones for the same key. Leaves the
+// receiver unchanged. A nil receiver is treated as the empty map.
+//
+// Parameters:
+//   - seq: The iterator of key-value pairs to insert.
+//
+// Returns:
+//   - *ImmutableOrderedMap[K, V]: The new map. Never returns nil.
+func (m *ImmutableOrderedMap[K, V]) Insert(seq iter.Seq2[K, V]) *ImmutableOrderedMap[K, V] {
+	result := m
+	if result == nil {
+		result = NewImmutableOrderedMap[K, V]()
+	}
+
+	if seq == nil {
+		return result
+	}
+
+	for key, value := range seq {
+		result = result.Set(key, value)
+	}
+
+	return result
+}
+
+// All returns an iterator that iterates over the entries in the map in key
+// order.
+//
+// Returns:
+//   - iter.Seq2[K, V]: The iterator. Never returns nil.
+func (m *ImmutableOrderedMap[K, V]) All() iter.Seq2[K, V] {
+	var root *treapNode[K, V]
+
+	if m != nil {
+		root = m.root
+	}
+
+	return func(yield func(K, V) bool) {
+		treapAll(root, yield)
+	}
+}
+
+// ImmutableOrderedMapBuilder accumulates key-value pairs into a single
+// treap without wrapping every intermediate step in an
+// ImmutableOrderedMap, giving O(n log n) bulk construction that produces
+// exactly one user-visible version.
+type ImmutableOrderedMapBuilder[K cmp.Ordered, V any] struct {
+	// root is the treap built up so far.
+	root *treapNode[K, V]
+}
+
+// NewImmutableOrderedMapBuilder creates a new empty builder.
+//
+// Returns:
+//   - *ImmutableOrderedMapBuilder[K, V]: The created builder. Never returns nil.
+func NewImmutableOrderedMapBuilder[K cmp.Ordered, V any]() *ImmutableOrderedMapBuilder[K, V] {
+	return &ImmutableOrderedMapBuilder[K, V]{}
+}
+
+// Add binds key to value in the builder and returns the receiver, so calls
+// can be chained. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - key: The key to bind.
+//   - value: The value to bind it to.
+//
+// Returns:
+//   - *ImmutableOrderedMapBuilder[K, V]: The receiver.
+func (b *ImmutableOrderedMapBuilder[K, V]) Add(key K, value V) *ImmutableOrderedMapBuilder[K, V] {
+	if b == nil {
+		return b
+	}
+
+	b.root = treapInsert(b.root, key, value, rand.Uint64())
+
+	return b
+}
+
+// Build produces the ImmutableOrderedMap accumulated so far.
+//
+// Returns:
+//   - *ImmutableOrderedMap[K, V]: The built map. Never returns nil.
+func (b *ImmutableOrderedMapBuilder[K, V]) Build() *ImmutableOrderedMap[K, V] {
+	if b == nil {
+		return NewImmutableOrderedMap[K, V]()
+	}
+
+	return &ImmutableOrderedMap[K, V]{root: b.root}
+}
@@ -0,0 +1,178 @@
+package sets
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// TestImmutableOrderedMapAgainstReference drives a random sequence of Set
+// and Delete operations through both an ImmutableOrderedMap and a plain Go
+// map used as a reference, checking after every step that Get, Len and the
+// key order produced by All agree.
+func TestImmutableOrderedMapAgainstReference(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	m := NewImmutableOrderedMap[int, int]()
+	reference := make(map[int]int)
+
+	const keySpace = 64
+	const steps = 2000
+
+	for i := 0; i < steps; i++ {
+		key := rng.Intn(keySpace)
+
+		if rng.Intn(3) == 0 {
+			delete(reference, key)
+			m = m.Delete(key)
+		} else {
+			value := rng.Int()
+			reference[key] = value
+			m = m.Set(key, value)
+		}
+
+		if m.Len() != len(reference) {
+			t.Fatalf("step %d: Len() = %d, want %d", i, m.Len(), len(reference))
+		}
+
+		for key, want := range reference {
+			got, ok := m.Get(key)
+			if !ok || got != want {
+				t.Fatalf("step %d: Get(%d) = (%d, %v), want (%d, true)", i, key, got, ok, want)
+			}
+		}
+
+		assertImmutableOrderedMapMatchesReference(t, i, m, reference)
+	}
+}
+
+// assertImmutableOrderedMapMatchesReference checks that iterating m with All
+// yields every entry of reference, in strictly increasing key order.
+func assertImmutableOrderedMapMatchesReference(t *testing.T, step int, m *ImmutableOrderedMap[int, int], reference map[int]int) {
+	t.Helper()
+
+	var keys []int
+
+	prevKey, hasPrev := 0, false
+
+	for key, value := range m.All() {
+		if hasPrev && key <= prevKey {
+			t.Fatalf("step %d: All() yielded key %d out of order after %d", step, key, prevKey)
+		}
+
+		want, ok := reference[key]
+		if !ok || want != value {
+			t.Fatalf("step %d: All() yielded (%d, %d), reference has (%d, %v)", step, key, value, want, ok)
+		}
+
+		keys = append(keys, key)
+		prevKey, hasPrev = key, true
+	}
+
+	if len(keys) != len(reference) {
+		t.Fatalf("step %d: All() yielded %d entries, want %d", step, len(keys), len(reference))
+	}
+}
+
+// TestImmutableOrderedMapPersistence checks that Set and Delete never
+// mutate the receiver: every previously obtained version must keep
+// observing its own snapshot of the map.
+func TestImmutableOrderedMapPersistence(t *testing.T) {
+	versions := make([]*ImmutableOrderedMap[int, string], 0, 17)
+	wantLens := make([]int, 0, 17)
+	reference := make(map[int]string)
+	m := NewImmutableOrderedMap[int, string]()
+	versions = append(versions, m)
+	wantLens = append(wantLens, 0)
+
+	for i := 0; i < 16; i++ {
+		if i%4 == 3 {
+			m = m.Delete(i - 1)
+			delete(reference, i-1)
+		} else {
+			m = m.Set(i, "v")
+			reference[i] = "v"
+		}
+
+		versions = append(versions, m)
+		wantLens = append(wantLens, len(reference))
+	}
+
+	for i, version := range versions {
+		if version.Len() != wantLens[i] {
+			t.Fatalf("versions[%d].Len() = %d, want %d (earlier versions must stay frozen)", i, version.Len(), wantLens[i])
+		}
+	}
+}
+
+// TestImmutableOrderedMapBuilder checks that the builder produces a map
+// equivalent to the same keys inserted one at a time via Set.
+func TestImmutableOrderedMapBuilder(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+
+	keys := rng.Perm(200)
+
+	builder := NewImmutableOrderedMapBuilder[int, int]()
+	want := NewImmutableOrderedMap[int, int]()
+
+	for _, key := range keys {
+		builder.Add(key, key*key)
+		want = want.Set(key, key*key)
+	}
+
+	built := builder.Build()
+
+	if built.Len() != want.Len() {
+		t.Fatalf("Build().Len() = %d, want %d", built.Len(), want.Len())
+	}
+
+	var builtKeys, wantKeys []int
+
+	for key := range built.All() {
+		builtKeys = append(builtKeys, key)
+	}
+	for key := range want.All() {
+		wantKeys = append(wantKeys, key)
+	}
+
+	if !slices.Equal(builtKeys, wantKeys) {
+		t.Fatalf("Build() key order = %v, want %v", builtKeys, wantKeys)
+	}
+
+	for key := range want.All() {
+		wantValue, _ := want.Get(key)
+
+		builtValue, ok := built.Get(key)
+		if !ok || builtValue != wantValue {
+			t.Fatalf("Build().Get(%d) = (%d, %v), want (%d, true)", key, builtValue, ok, wantValue)
+		}
+	}
+}
+
+// TestImmutableOrderedMapNilReceiver checks that a nil *ImmutableOrderedMap
+// behaves like the empty map, as documented.
+func TestImmutableOrderedMapNilReceiver(t *testing.T) {
+	var m *ImmutableOrderedMap[int, int]
+
+	if m.Len() != 0 {
+		t.Fatalf("nil.Len() = %d, want 0", m.Len())
+	}
+
+	if _, ok := m.Get(1); ok {
+		t.Fatalf("nil.Get(1) reported a hit")
+	}
+
+	for range m.All() {
+		t.Fatalf("nil.All() yielded an entry")
+	}
+
+	m2 := m.Set(1, 2)
+	if m2.Len() != 1 {
+		t.Fatalf("nil.Set(1, 2).Len() = %d, want 1", m2.Len())
+	}
+
+	m3 := m.Delete(1)
+	if m3.Len() != 0 {
+		t.Fatalf("nil.Delete(1).Len() = %d, want 0", m3.Len())
+	}
+}
@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"reflect"
+	"sort"
+)
+
+// TrySort sorts elems in place, ascending, if T is one of Go's built-in
+// ordered kinds (the integer, float, and string kinds). It is a no-op,
+// returning false, for any other kind, including when elems has fewer than
+// two elements.
+//
+// Parameters:
+//   - elems: The slice to sort, in place.
+//
+// Returns:
+//   - bool: True if elems was sorted. False otherwise.
+func TrySort[T any](elems []T) bool {
+	if len(elems) < 2 {
+		return false
+	}
+
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sort.Slice(elems, func(i, j int) bool {
+			return reflect.ValueOf(elems[i]).Int() < reflect.ValueOf(elems[j]).Int()
+		})
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		sort.Slice(elems, func(i, j int) bool {
+			return reflect.ValueOf(elems[i]).Uint() < reflect.ValueOf(elems[j]).Uint()
+		})
+	case reflect.Float32, reflect.Float64:
+		sort.Slice(elems, func(i, j int) bool {
+			return reflect.ValueOf(elems[i]).Float() < reflect.ValueOf(elems[j]).Float()
+		})
+	case reflect.String:
+		sort.Slice(elems, func(i, j int) bool {
+			return reflect.ValueOf(elems[i]).String() < reflect.ValueOf(elems[j]).String()
+		})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// IsStringKind reports whether T's underlying kind is string. Used to
+// decide, at runtime, whether a type parameter can be treated as a string
+// for serialization purposes.
+//
+// Returns:
+//   - bool: True if T's underlying kind is string. False otherwise.
+func IsStringKind[T any]() bool {
+	var zero T
+
+	t := reflect.TypeOf(zero)
+	return t != nil && t.Kind() == reflect.String
+}
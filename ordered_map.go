@@ -1,9 +1,17 @@
 package sets
 
 import (
+	"bytes"
 	"cmp"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
 	"iter"
+	"reflect"
 	"slices"
+	"strings"
+
+	"github.com/PlayerR9/go-sets/internal"
 )
 
 // OrderedMap is a map that is ordered by the keys.
@@ -144,6 +152,441 @@ func (m *OrderedMap[K, V]) ForceAdd(key K, value V) {
 	m.values[key] = value
 }
 
+// Min returns the entry with the smallest key in the map.
+//
+// Returns:
+//   - K: The smallest key.
+//   - V: Its value.
+//   - bool: True if the map is non-empty. False otherwise.
+func (m OrderedMap[K, V]) Min() (K, V, bool) {
+	if len(m.keys) == 0 {
+		return *new(K), *new(V), false
+	}
+
+	key := m.keys[0]
+	return key, m.values[key], true
+}
+
+// Max returns the entry with the largest key in the map.
+//
+// Returns:
+//   - K: The largest key.
+//   - V: Its value.
+//   - bool: True if the map is non-empty. False otherwise.
+func (m OrderedMap[K, V]) Max() (K, V, bool) {
+	if len(m.keys) == 0 {
+		return *new(K), *new(V), false
+	}
+
+	key := m.keys[len(m.keys)-1]
+	return key, m.values[key], true
+}
+
+// Floor returns the entry whose key is the largest one less than or equal
+// to key.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - K: The floor key.
+//   - V: Its value.
+//   - bool: True if such a key exists. False otherwise.
+func (m OrderedMap[K, V]) Floor(key K) (K, V, bool) {
+	if len(m.keys) == 0 {
+		return *new(K), *new(V), false
+	}
+
+	pos, ok := slices.BinarySearch(m.keys, key)
+	if ok {
+		return key, m.values[key], true
+	}
+
+	if pos == 0 {
+		return *new(K), *new(V), false
+	}
+
+	floor_key := m.keys[pos-1]
+	return floor_key, m.values[floor_key], true
+}
+
+// Ceiling returns the entry whose key is the smallest one greater than or
+// equal to key.
+//
+// Parameters:
+//   - key: The key to search for.
+//
+// Returns:
+//   - K: The ceiling key.
+//   - V: Its value.
+//   - bool: True if such a key exists. False otherwise.
+func (m OrderedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	if len(m.keys) == 0 {
+		return *new(K), *new(V), false
+	}
+
+	pos, ok := slices.BinarySearch(m.keys, key)
+	if ok {
+		return key, m.values[key], true
+	}
+
+	if pos == len(m.keys) {
+		return *new(K), *new(V), false
+	}
+
+	ceil_key := m.keys[pos]
+	return ceil_key, m.values[ceil_key], true
+}
+
+// PopMin removes and returns the entry with the smallest key in the map.
+// Does nothing and returns false if the receiver is nil or empty.
+//
+// Returns:
+//   - K: The smallest key.
+//   - V: Its value.
+//   - bool: True if an entry was removed. False otherwise.
+func (m *OrderedMap[K, V]) PopMin() (K, V, bool) {
+	if m == nil || len(m.keys) == 0 {
+		return *new(K), *new(V), false
+	}
+
+	key := m.keys[0]
+	value := m.values[key]
+	m.Remove(key)
+
+	return key, value, true
+}
+
+// PopMax removes and returns the entry with the largest key in the map.
+// Does nothing and returns false if the receiver is nil or empty.
+//
+// Returns:
+//   - K: The largest key.
+//   - V: Its value.
+//   - bool: True if an entry was removed. False otherwise.
+func (m *OrderedMap[K, V]) PopMax() (K, V, bool) {
+	if m == nil || len(m.keys) == 0 {
+		return *new(K), *new(V), false
+	}
+
+	key := m.keys[len(m.keys)-1]
+	value := m.values[key]
+	m.Remove(key)
+
+	return key, value, true
+}
+
+// Range returns an iterator over the entries whose keys lie in the
+// half-open interval [lo, hi), in key order.
+//
+// Parameters:
+//   - lo: The inclusive lower bound.
+//   - hi: The exclusive upper bound.
+//
+// Returns:
+//   - iter.Seq2[K, V]: The iterator. Never returns nil.
+func (m OrderedMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	if len(m.keys) == 0 {
+		return func(yield func(K, V) bool) {}
+	}
+
+	start, _ := slices.BinarySearch(m.keys, lo)
+	end, _ := slices.BinarySearch(m.keys, hi)
+
+	if start >= end {
+		return func(yield func(K, V) bool) {}
+	}
+
+	keys := m.keys[start:end]
+	values := m.values
+
+	return func(yield func(K, V) bool) {
+		for _, key := range keys {
+			if !yield(key, values[key]) {
+				return
+			}
+		}
+	}
+}
+
+// PrefixRange returns an iterator over the entries of m whose key starts
+// with prefix, in key order. It is a package-level function, rather than a
+// method, because it only makes sense when K is a string-like type.
+//
+// Parameters:
+//   - m: The map to iterate over.
+//   - prefix: The prefix to match.
+//
+// Returns:
+//   - iter.Seq2[K, V]: The iterator. Never returns nil.
+func PrefixRange[K ~string, V any](m *OrderedMap[K, V], prefix string) iter.Seq2[K, V] {
+	if m == nil || len(m.keys) == 0 {
+		return func(yield func(K, V) bool) {}
+	}
+
+	start, _ := slices.BinarySearch(m.keys, K(prefix))
+
+	return func(yield func(K, V) bool) {
+		for i := start; i < len(m.keys); i++ {
+			key := m.keys[i]
+			if !strings.HasPrefix(string(key), prefix) {
+				return
+			}
+
+			if !yield(key, m.values[key]) {
+				return
+			}
+		}
+	}
+}
+
+// Union adds all key-value pairs from another map to the map. Existing keys
+// are left untouched.
+//
+// Parameters:
+//   - other: The other map to add.
+//
+// Returns:
+//   - int: The number of key-value pairs added.
+//
+// If the receiver or 'other' is nil, then 0 is returned, always.
+func (m *OrderedMap[K, V]) Union(other *OrderedMap[K, V]) int {
+	if m == nil || other == nil {
+		return 0
+	}
+
+	var count int
+
+	for _, key := range other.keys {
+		pos, ok := slices.BinarySearch(m.keys, key)
+		if !ok {
+			m.keys = slices.Insert(m.keys, pos, key)
+			m.values[key] = other.values[key]
+			count++
+		}
+	}
+
+	return count
+}
+
+// UnionInPlace is the same as Union, except that it discards the count of
+// key-value pairs added.
+//
+// Parameters:
+//   - other: The other map to add.
+func (m *OrderedMap[K, V]) UnionInPlace(other *OrderedMap[K, V]) {
+	_ = m.Union(other)
+}
+
+// Intersect returns a new map containing the entries whose keys are present
+// in both the receiver and 'other'. Values are taken from the receiver.
+// Neither the receiver nor 'other' are modified.
+//
+// Parameters:
+//   - other: The other map to intersect with.
+//
+// Returns:
+//   - *OrderedMap[K, V]: The new map. Never returns nil.
+func (m *OrderedMap[K, V]) Intersect(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	result := NewOrderedMap[K, V]()
+
+	if m == nil || other == nil {
+		return result
+	}
+
+	for _, key := range m.keys {
+		if other.Contains(key) {
+			result.Add(key, m.values[key])
+		}
+	}
+
+	return result
+}
+
+// IntersectInPlace removes from the receiver every entry whose key is not
+// also in 'other'. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - other: The other map to intersect with.
+func (m *OrderedMap[K, V]) IntersectInPlace(other *OrderedMap[K, V]) {
+	if m == nil || len(m.keys) == 0 {
+		return
+	}
+
+	if other == nil {
+		m.Reset()
+		return
+	}
+
+	for _, key := range slices.Clone(m.keys) {
+		if !other.Contains(key) {
+			m.Remove(key)
+		}
+	}
+}
+
+// Difference returns a new map containing the entries of the receiver whose
+// keys are not in 'other'. Neither the receiver nor 'other' are modified.
+//
+// Parameters:
+//   - other: The other map to subtract.
+//
+// Returns:
+//   - *OrderedMap[K, V]: The new map. Never returns nil.
+func (m *OrderedMap[K, V]) Difference(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	result := NewOrderedMap[K, V]()
+
+	if m == nil {
+		return result
+	}
+
+	for _, key := range m.keys {
+		if other == nil || !other.Contains(key) {
+			result.Add(key, m.values[key])
+		}
+	}
+
+	return result
+}
+
+// DifferenceInPlace removes from the receiver every entry whose key is also
+// in 'other'. Does nothing if the receiver or 'other' is nil.
+//
+// Parameters:
+//   - other: The other map to subtract.
+func (m *OrderedMap[K, V]) DifferenceInPlace(other *OrderedMap[K, V]) {
+	if m == nil || len(m.keys) == 0 || other == nil {
+		return
+	}
+
+	for _, key := range other.keys {
+		m.Remove(key)
+	}
+}
+
+// SymmetricDifference returns a new map containing the entries whose keys
+// belong to exactly one of the receiver and 'other'. Neither the receiver
+// nor 'other' are modified.
+//
+// Parameters:
+//   - other: The other map.
+//
+// Returns:
+//   - *OrderedMap[K, V]: The new map. Never returns nil.
+func (m *OrderedMap[K, V]) SymmetricDifference(other *OrderedMap[K, V]) *OrderedMap[K, V] {
+	result := NewOrderedMap[K, V]()
+
+	if m != nil {
+		for _, key := range m.keys {
+			if other == nil || !other.Contains(key) {
+				result.Add(key, m.values[key])
+			}
+		}
+	}
+
+	if other != nil {
+		for _, key := range other.keys {
+			if m == nil || !m.Contains(key) {
+				result.Add(key, other.values[key])
+			}
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifferenceInPlace replaces the contents of the receiver with the
+// symmetric difference of the receiver and 'other'. Does nothing if the
+// receiver is nil.
+//
+// Parameters:
+//   - other: The other map.
+func (m *OrderedMap[K, V]) SymmetricDifferenceInPlace(other *OrderedMap[K, V]) {
+	if m == nil {
+		return
+	}
+
+	result := m.SymmetricDifference(other)
+
+	m.keys = result.keys
+	m.values = result.values
+}
+
+// IsSubset checks whether every key of the receiver is also a key of
+// 'other'. A nil or empty receiver is a subset of any map, including nil.
+//
+// Parameters:
+//   - other: The other map.
+//
+// Returns:
+//   - bool: True if the receiver's keys are a subset of other's keys. False
+//     otherwise.
+func (m *OrderedMap[K, V]) IsSubset(other *OrderedMap[K, V]) bool {
+	if m == nil || len(m.keys) == 0 {
+		return true
+	}
+
+	if other == nil {
+		return false
+	}
+
+	for _, key := range m.keys {
+		if !other.Contains(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset checks whether every key of 'other' is also a key of the
+// receiver.
+//
+// Parameters:
+//   - other: The other map.
+//
+// Returns:
+//   - bool: True if the receiver's keys are a superset of other's keys. False
+//     otherwise.
+func (m *OrderedMap[K, V]) IsSuperset(other *OrderedMap[K, V]) bool {
+	return other.IsSubset(m)
+}
+
+// Equals checks whether the receiver and 'other' contain exactly the same
+// keys, each mapped to an equal value (compared via reflect.DeepEqual).
+//
+// Parameters:
+//   - other: The other map.
+//
+// Returns:
+//   - bool: True if both maps contain the same entries. False otherwise.
+func (m *OrderedMap[K, V]) Equals(other *OrderedMap[K, V]) bool {
+	var m_len, other_len int
+
+	if m != nil {
+		m_len = len(m.keys)
+	}
+	if other != nil {
+		other_len = len(other.keys)
+	}
+
+	if m_len != other_len || !m.IsSubset(other) {
+		return false
+	}
+
+	if m_len == 0 {
+		return true
+	}
+
+	for _, key := range m.keys {
+		if !reflect.DeepEqual(m.values[key], other.values[key]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Map returns a copy of the map of the values in the map.
 //
 // Returns:
@@ -195,3 +638,318 @@ func (m OrderedMap[K, V]) Entry() iter.Seq2[K, V] {
 		}
 	}
 }
+
+// All is the same as Entry. It mirrors the naming of the stdlib maps.All
+// function.
+//
+// Returns:
+//   - iter.Seq2[K, V]: The iterator. Never returns nil.
+func (m OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return m.Entry()
+}
+
+// Values returns an iterator that iterates over the values in the map
+// according to the order of the keys. Mirrors the stdlib maps.Values
+// function.
+//
+// Returns:
+//   - iter.Seq[V]: The iterator. Never returns nil.
+func (m OrderedMap[K, V]) Values() iter.Seq[V] {
+	if len(m.keys) == 0 {
+		return func(yield func(V) bool) {}
+	}
+
+	return func(yield func(value V) bool) {
+		for _, key := range m.keys {
+			if !yield(m.values[key]) {
+				break
+			}
+		}
+	}
+}
+
+// Insert adds every key-value pair yielded by seq to the map, overwriting
+// the value of any key that already exists. Mirrors the stdlib maps.Insert
+// function. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - seq: The iterator of key-value pairs to insert.
+func (m *OrderedMap[K, V]) Insert(seq iter.Seq2[K, V]) {
+	if m == nil || seq == nil {
+		return
+	}
+
+	for key, value := range seq {
+		m.ForceAdd(key, value)
+	}
+}
+
+// CollectOrdered builds a new OrderedMap out of the key-value pairs yielded
+// by seq. Mirrors the stdlib maps.Collect function.
+//
+// Parameters:
+//   - seq: The iterator of key-value pairs to collect.
+//
+// Returns:
+//   - *OrderedMap[K, V]: The new map. Never returns nil.
+func CollectOrdered[K cmp.Ordered, V any](seq iter.Seq2[K, V]) *OrderedMap[K, V] {
+	m := NewOrderedMap[K, V]()
+	m.Insert(seq)
+
+	return m
+}
+
+// MarshalJSON implements the json.Marshaler interface. When K's underlying
+// kind is string, the map is encoded as a JSON object, in key order.
+// Otherwise it is encoded as a JSON array of [key, value] pairs, in key
+// order.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	if internal.IsStringKind[K]() {
+		return m.marshalJSONObject()
+	}
+
+	return m.marshalJSONPairs()
+}
+
+// marshalJSONObject encodes the map as a JSON object, in key order.
+func (m OrderedMap[K, V]) marshalJSONObject() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key_bytes, err := json.Marshal(reflect.ValueOf(key).String())
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key_bytes)
+		buf.WriteByte(':')
+
+		value_bytes, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(value_bytes)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// marshalJSONPairs encodes the map as a JSON array of [key, value] pairs,
+// in key order.
+func (m OrderedMap[K, V]) marshalJSONPairs() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('[')
+
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		pair_bytes, err := json.Marshal([2]any{key, m.values[key]})
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(pair_bytes)
+	}
+
+	buf.WriteByte(']')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts
+// either representation produced by MarshalJSON and replaces the contents
+// of the receiver. The decoded entries are kept in key order, whatever
+// their order in data.
+// Does nothing and returns nil if the receiver is nil or data is the JSON
+// literal null, per the json.Unmarshaler convention. The receiver is left
+// untouched if data does not hold a valid representation.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	if m == nil || string(data) == "null" {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return fmt.Errorf("sets: unexpected JSON token %v for OrderedMap", tok)
+	}
+
+	tmp := &OrderedMap[K, V]{
+		keys:   make([]K, 0),
+		values: make(map[K]V),
+	}
+
+	switch delim {
+	case json.Delim('{'):
+		err = tmp.unmarshalJSONObject(dec)
+	case json.Delim('['):
+		err = tmp.unmarshalJSONPairs(dec)
+	default:
+		err = fmt.Errorf("sets: unexpected JSON delimiter %q for OrderedMap", delim)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	m.keys = tmp.keys
+	m.values = tmp.values
+
+	return nil
+}
+
+// unmarshalJSONObject reads key-value pairs from a JSON object token stream,
+// in the order they appear.
+func (m *OrderedMap[K, V]) unmarshalJSONObject(dec *json.Decoder) error {
+	for dec.More() {
+		key_tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key_str, ok := key_tok.(string)
+		if !ok {
+			return fmt.Errorf("sets: unexpected JSON object key %v for OrderedMap", key_tok)
+		}
+
+		key, err := stringToKey[K](key_str)
+		if err != nil {
+			return err
+		}
+
+		var value V
+
+		err = dec.Decode(&value)
+		if err != nil {
+			return err
+		}
+
+		m.ForceAdd(key, value)
+	}
+
+	// Consume the closing '}'.
+	_, err := dec.Token()
+	return err
+}
+
+// unmarshalJSONPairs reads [key, value] pairs from a JSON array token
+// stream, in the order they appear.
+func (m *OrderedMap[K, V]) unmarshalJSONPairs(dec *json.Decoder) error {
+	for dec.More() {
+		var pair [2]json.RawMessage
+
+		err := dec.Decode(&pair)
+		if err != nil {
+			return err
+		}
+
+		var key K
+
+		err = json.Unmarshal(pair[0], &key)
+		if err != nil {
+			return err
+		}
+
+		var value V
+
+		err = json.Unmarshal(pair[1], &value)
+		if err != nil {
+			return err
+		}
+
+		m.ForceAdd(key, value)
+	}
+
+	// Consume the closing ']'.
+	_, err := dec.Token()
+	return err
+}
+
+// stringToKey converts s into a K whose underlying kind must be string.
+//
+// Parameters:
+//   - s: The string to convert.
+//
+// Returns:
+//   - K: The converted key.
+//   - error: An error if K's underlying kind is not string.
+func stringToKey[K any](s string) (K, error) {
+	var zero K
+
+	rt := reflect.TypeOf(zero)
+	if rt == nil || rt.Kind() != reflect.String {
+		return zero, fmt.Errorf("sets: %T is not a string-like key type", zero)
+	}
+
+	rv := reflect.New(rt).Elem()
+	rv.SetString(s)
+
+	return rv.Interface().(K), nil
+}
+
+// orderedMapPair is the gob wire format for a single OrderedMap entry.
+type orderedMapPair[K cmp.Ordered, V any] struct {
+	Key   K
+	Value V
+}
+
+// GobEncode implements the gob.GobEncoder interface. The map is encoded as
+// a gob-encoded slice of key-value pairs, in key order.
+func (m OrderedMap[K, V]) GobEncode() ([]byte, error) {
+	pairs := make([]orderedMapPair[K, V], 0, len(m.keys))
+	for _, key := range m.keys {
+		pairs = append(pairs, orderedMapPair[K, V]{Key: key, Value: m.values[key]})
+	}
+
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(pairs)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface. It replaces the
+// contents of the receiver with the entries decoded from data, preserving
+// their order. Does nothing and returns nil if the receiver is nil.
+func (m *OrderedMap[K, V]) GobDecode(data []byte) error {
+	if m == nil {
+		return nil
+	}
+
+	var pairs []orderedMapPair[K, V]
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&pairs)
+	if err != nil {
+		return err
+	}
+
+	m.keys = make([]K, 0, len(pairs))
+	m.values = make(map[K]V, len(pairs))
+
+	for _, pair := range pairs {
+		m.ForceAdd(pair.Key, pair.Value)
+	}
+
+	return nil
+}
@@ -1,6 +1,11 @@
 package sets
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"iter"
+
 	"github.com/PlayerR9/go-sets/internal"
 )
 
@@ -95,6 +100,391 @@ func (sm SeenSet[T]) Has(v T) bool {
 	return ok
 }
 
+// Union adds all values from another set to the set.
+//
+// Parameters:
+//   - other: The other set to add.
+//
+// Returns:
+//   - int: The number of values added.
+//
+// If the receiver or 'other' is nil, then 0 is returned, always.
+func (sm *SeenSet[T]) Union(other *SeenSet[T]) int {
+	if sm == nil || other == nil {
+		return 0
+	}
+
+	var count int
+
+	for v := range other.table {
+		_, ok := sm.table[v]
+		if !ok {
+			sm.table[v] = struct{}{}
+			count++
+		}
+	}
+
+	return count
+}
+
+// UnionInPlace is the same as Union, except that it discards the count of
+// values added.
+//
+// Parameters:
+//   - other: The other set to add.
+func (sm *SeenSet[T]) UnionInPlace(other *SeenSet[T]) {
+	_ = sm.Union(other)
+}
+
+// Intersect returns a new set containing the values that are present in both
+// the receiver and 'other'. Neither the receiver nor 'other' are modified.
+//
+// Parameters:
+//   - other: The other set to intersect with.
+//
+// Returns:
+//   - *SeenSet[T]: The new set. Never returns nil.
+func (sm *SeenSet[T]) Intersect(other *SeenSet[T]) *SeenSet[T] {
+	result := NewSeenSet[T]()
+
+	if sm == nil || other == nil {
+		return result
+	}
+
+	for v := range sm.table {
+		_, ok := other.table[v]
+		if ok {
+			result.table[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// IntersectInPlace removes from the receiver every value that is not also in
+// 'other'. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - other: The other set to intersect with.
+func (sm *SeenSet[T]) IntersectInPlace(other *SeenSet[T]) {
+	if sm == nil || len(sm.table) == 0 {
+		return
+	}
+
+	if other == nil {
+		sm.Reset()
+		return
+	}
+
+	for v := range sm.table {
+		_, ok := other.table[v]
+		if !ok {
+			delete(sm.table, v)
+		}
+	}
+}
+
+// Difference returns a new set containing the values that are in the
+// receiver but not in 'other'. Neither the receiver nor 'other' are
+// modified.
+//
+// Parameters:
+//   - other: The other set to subtract.
+//
+// Returns:
+//   - *SeenSet[T]: The new set. Never returns nil.
+func (sm *SeenSet[T]) Difference(other *SeenSet[T]) *SeenSet[T] {
+	result := NewSeenSet[T]()
+
+	if sm == nil {
+		return result
+	}
+
+	for v := range sm.table {
+		var ok bool
+
+		if other != nil {
+			_, ok = other.table[v]
+		}
+
+		if !ok {
+			result.table[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// DifferenceInPlace removes from the receiver every value that is also in
+// 'other'. Does nothing if the receiver or 'other' is nil.
+//
+// Parameters:
+//   - other: The other set to subtract.
+func (sm *SeenSet[T]) DifferenceInPlace(other *SeenSet[T]) {
+	if sm == nil || len(sm.table) == 0 || other == nil {
+		return
+	}
+
+	for v := range other.table {
+		delete(sm.table, v)
+	}
+}
+
+// SymmetricDifference returns a new set containing the values that belong to
+// exactly one of the receiver and 'other'. Neither the receiver nor 'other'
+// are modified.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - *SeenSet[T]: The new set. Never returns nil.
+func (sm *SeenSet[T]) SymmetricDifference(other *SeenSet[T]) *SeenSet[T] {
+	result := NewSeenSet[T]()
+
+	if sm != nil {
+		for v := range sm.table {
+			var ok bool
+
+			if other != nil {
+				_, ok = other.table[v]
+			}
+
+			if !ok {
+				result.table[v] = struct{}{}
+			}
+		}
+	}
+
+	if other != nil {
+		for v := range other.table {
+			var ok bool
+
+			if sm != nil {
+				_, ok = sm.table[v]
+			}
+
+			if !ok {
+				result.table[v] = struct{}{}
+			}
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifferenceInPlace replaces the contents of the receiver with the
+// symmetric difference of the receiver and 'other'. Does nothing if the
+// receiver is nil.
+//
+// Parameters:
+//   - other: The other set.
+func (sm *SeenSet[T]) SymmetricDifferenceInPlace(other *SeenSet[T]) {
+	if sm == nil {
+		return
+	}
+
+	sm.table = sm.SymmetricDifference(other).table
+}
+
+// IsSubset checks whether every value of the receiver is also a value of
+// 'other'. A nil or empty receiver is a subset of any set, including nil.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - bool: True if the receiver is a subset of 'other'. False otherwise.
+func (sm *SeenSet[T]) IsSubset(other *SeenSet[T]) bool {
+	if sm == nil || len(sm.table) == 0 {
+		return true
+	}
+
+	if other == nil {
+		return false
+	}
+
+	for v := range sm.table {
+		_, ok := other.table[v]
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset checks whether every value of 'other' is also a value of the
+// receiver.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - bool: True if the receiver is a superset of 'other'. False otherwise.
+func (sm *SeenSet[T]) IsSuperset(other *SeenSet[T]) bool {
+	return other.IsSubset(sm)
+}
+
+// Equals checks whether the receiver and 'other' contain exactly the same
+// values.
+//
+// Parameters:
+//   - other: The other set.
+//
+// Returns:
+//   - bool: True if both sets contain the same values. False otherwise.
+func (sm *SeenSet[T]) Equals(other *SeenSet[T]) bool {
+	var sm_len, other_len int
+
+	if sm != nil {
+		sm_len = len(sm.table)
+	}
+	if other != nil {
+		other_len = len(other.table)
+	}
+
+	return sm_len == other_len && sm.IsSubset(other)
+}
+
+// MarshalJSON implements the json.Marshaler interface. The set is encoded
+// as a JSON array. Values are sorted when T is one of Go's built-in ordered
+// kinds; otherwise the order is unspecified map-iteration order.
+func (sm SeenSet[T]) MarshalJSON() ([]byte, error) {
+	elems := make([]T, 0, len(sm.table))
+	for v := range sm.table {
+		elems = append(elems, v)
+	}
+
+	internal.TrySort(elems)
+
+	return json.Marshal(elems)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It replaces the
+// contents of the receiver with the values decoded from a JSON array. Does
+// nothing and returns nil if the receiver is nil or data is the JSON literal
+// null, per the json.Unmarshaler convention.
+func (sm *SeenSet[T]) UnmarshalJSON(data []byte) error {
+	if sm == nil || string(data) == "null" {
+		return nil
+	}
+
+	var elems []T
+
+	err := json.Unmarshal(data, &elems)
+	if err != nil {
+		return err
+	}
+
+	table := make(map[T]struct{}, len(elems))
+	for _, v := range elems {
+		table[v] = struct{}{}
+	}
+
+	sm.table = table
+
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface. The set is encoded as
+// a gob-encoded slice, in the same order as MarshalJSON.
+func (sm SeenSet[T]) GobEncode() ([]byte, error) {
+	elems := make([]T, 0, len(sm.table))
+	for v := range sm.table {
+		elems = append(elems, v)
+	}
+
+	internal.TrySort(elems)
+
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(elems)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface. It replaces the
+// contents of the receiver with the values decoded from data. Does nothing
+// and returns nil if the receiver is nil.
+func (sm *SeenSet[T]) GobDecode(data []byte) error {
+	if sm == nil {
+		return nil
+	}
+
+	var elems []T
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elems)
+	if err != nil {
+		return err
+	}
+
+	table := make(map[T]struct{}, len(elems))
+	for _, v := range elems {
+		table[v] = struct{}{}
+	}
+
+	sm.table = table
+
+	return nil
+}
+
+// All returns an iterator that iterates over all seen values. The order of
+// iteration is unspecified.
+//
+// Returns:
+//   - iter.Seq[T]: The iterator. Never returns nil.
+func (sm *SeenSet[T]) All() iter.Seq[T] {
+	var fn func(yield func(T) bool)
+
+	if sm == nil {
+		fn = func(yield func(T) bool) {}
+	} else {
+		fn = func(yield func(T) bool) {
+			for v := range sm.table {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+
+	return fn
+}
+
+// Insert sets every value yielded by seq as seen. Mirrors the stdlib
+// maps.Insert helper. Does nothing if the receiver is nil.
+//
+// Parameters:
+//   - seq: The iterator of values to insert.
+func (sm *SeenSet[T]) Insert(seq iter.Seq[T]) {
+	if sm == nil || seq == nil {
+		return
+	}
+
+	for v := range seq {
+		sm.SetSeen(v)
+	}
+}
+
+// Collect builds a new SeenSet out of the values yielded by seq. Mirrors
+// the stdlib maps.Collect helper.
+//
+// Parameters:
+//   - seq: The iterator of values to collect.
+//
+// Returns:
+//   - *SeenSet[T]: The new set. Never returns nil.
+func Collect[T comparable](seq iter.Seq[T]) *SeenSet[T] {
+	sm := NewSeenSet[T]()
+	sm.Insert(seq)
+
+	return sm
+}
+
 // FilterSeen returns the elements that are seen. The order of the elements is preserved
 // and no duplicates are contained.
 //